@@ -0,0 +1,43 @@
+package usesend
+
+import "context"
+
+type ApiKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Token is only populated in the response to Create; useSend never
+	// returns it again afterwards.
+	Token     string `json:"token,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type CreateApiKeyPayload struct {
+	Name string `json:"name"`
+}
+
+type RevokeApiKeyResponse struct {
+	Success bool `json:"success"`
+}
+
+// ApiKeysService manages API keys for the team the calling key belongs to.
+type ApiKeysService struct {
+	client *Client
+}
+
+func (a *ApiKeysService) Create(ctx context.Context, payload CreateApiKeyPayload, opts ...RequestOption) (ApiKey, *ErrorResponse, error) {
+	var resp ApiKey
+	errResp, err := a.client.post(ctx, "/apiKeys", payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (a *ApiKeysService) List(ctx context.Context, opts ...RequestOption) ([]ApiKey, *ErrorResponse, error) {
+	var resp []ApiKey
+	errResp, err := a.client.get(ctx, "/apiKeys", &resp, opts...)
+	return resp, errResp, err
+}
+
+func (a *ApiKeysService) Revoke(ctx context.Context, id string, opts ...RequestOption) (RevokeApiKeyResponse, *ErrorResponse, error) {
+	var resp RevokeApiKeyResponse
+	errResp, err := a.client.delete(ctx, "/apiKeys/"+id, nil, &resp, opts...)
+	return resp, errResp, err
+}
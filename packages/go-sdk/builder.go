@@ -0,0 +1,195 @@
+package usesend
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// EmailBuilder constructs a SendEmailPayload fluently, base64-encoding
+// attachments and validating required fields before the network
+// round-trip.
+type EmailBuilder struct {
+	payload SendEmailPayload
+	err     error
+}
+
+// NewEmail starts a new EmailBuilder.
+func NewEmail() *EmailBuilder {
+	return &EmailBuilder{}
+}
+
+func (b *EmailBuilder) From(from string) *EmailBuilder {
+	b.payload.From = from
+	return b
+}
+
+func (b *EmailBuilder) To(to ...string) *EmailBuilder {
+	b.payload.To = append(b.payload.To, to...)
+	return b
+}
+
+func (b *EmailBuilder) CC(cc ...string) *EmailBuilder {
+	b.payload.CC = append(b.payload.CC, cc...)
+	return b
+}
+
+func (b *EmailBuilder) BCC(bcc ...string) *EmailBuilder {
+	b.payload.BCC = append(b.payload.BCC, bcc...)
+	return b
+}
+
+func (b *EmailBuilder) ReplyTo(replyTo ...string) *EmailBuilder {
+	b.payload.ReplyTo = append(b.payload.ReplyTo, replyTo...)
+	return b
+}
+
+func (b *EmailBuilder) Subject(subject string) *EmailBuilder {
+	b.payload.Subject = subject
+	return b
+}
+
+func (b *EmailBuilder) Text(text string) *EmailBuilder {
+	b.payload.Text = text
+	return b
+}
+
+func (b *EmailBuilder) HTML(html string) *EmailBuilder {
+	b.payload.HTML = html
+	return b
+}
+
+func (b *EmailBuilder) Template(templateID string, variables map[string]string) *EmailBuilder {
+	b.payload.TemplateID = templateID
+	b.payload.Variables = variables
+	return b
+}
+
+// ReplyingTo threads this email onto an existing one, mirroring
+// SendEmailPayload.InReplyToID.
+func (b *EmailBuilder) ReplyingTo(emailID string) *EmailBuilder {
+	b.payload.InReplyToID = emailID
+	return b
+}
+
+// ScheduleAt marshals t to the RFC3339 form the API expects for
+// SendEmailPayload.ScheduledAt.
+func (b *EmailBuilder) ScheduleAt(t time.Time) *EmailBuilder {
+	b.payload.ScheduledAt = t.UTC().Format(time.RFC3339)
+	return b
+}
+
+// AttachFile reads path from disk, base64-encodes its contents and sniffs
+// its content type from the file extension.
+func (b *EmailBuilder) AttachFile(path string) *EmailBuilder {
+	if b.err != nil {
+		return b
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	defer f.Close()
+
+	return b.attach(filepath.Base(path), f, mime.TypeByExtension(filepath.Ext(path)))
+}
+
+// AttachReader reads all of r, base64-encodes it and attaches it under
+// filename with the given contentType.
+func (b *EmailBuilder) AttachReader(filename string, r io.Reader, contentType string) *EmailBuilder {
+	return b.attach(filename, r, contentType)
+}
+
+func (b *EmailBuilder) attach(filename string, r io.Reader, contentType string) *EmailBuilder {
+	if b.err != nil {
+		return b
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.payload.Attachments = append(b.payload.Attachments, Attachment{
+		Filename:    filename,
+		Content:     base64.StdEncoding.EncodeToString(data),
+		ContentType: contentType,
+	})
+	return b
+}
+
+// Build validates the payload and returns it. It returns the first error
+// encountered while building (e.g. from AttachFile) before reporting a
+// validation error, and requires a From address, at least one recipient,
+// and at least one of Text, HTML or TemplateID.
+func (b *EmailBuilder) Build() (SendEmailPayload, error) {
+	if b.err != nil {
+		return SendEmailPayload{}, b.err
+	}
+	if b.payload.From == "" {
+		return SendEmailPayload{}, errors.New("usesend: email requires a From address")
+	}
+	if len(b.payload.To) == 0 {
+		return SendEmailPayload{}, errors.New("usesend: email requires at least one recipient")
+	}
+	if b.payload.Text == "" && b.payload.HTML == "" && b.payload.TemplateID == "" {
+		return SendEmailPayload{}, errors.New("usesend: email requires Text, HTML or a TemplateID")
+	}
+	return b.payload, nil
+}
+
+// BatchEmailBuilder collects multiple EmailBuilders to dispatch in a single
+// EmailsService.Batch call.
+type BatchEmailBuilder struct {
+	builders []*EmailBuilder
+}
+
+// NewBatch starts a new BatchEmailBuilder.
+func NewBatch() *BatchEmailBuilder {
+	return &BatchEmailBuilder{}
+}
+
+// Add queues email for dispatch alongside the rest of the batch.
+func (b *BatchEmailBuilder) Add(email *EmailBuilder) *BatchEmailBuilder {
+	b.builders = append(b.builders, email)
+	return b
+}
+
+// Build validates each queued EmailBuilder and returns their payloads, or
+// the first error encountered.
+func (b *BatchEmailBuilder) Build() ([]SendEmailPayload, error) {
+	payloads := make([]SendEmailPayload, 0, len(b.builders))
+	for _, eb := range b.builders {
+		payload, err := eb.Build()
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, payload)
+	}
+	return payloads, nil
+}
+
+// SendBuilder validates b and sends the resulting payload.
+func (e *EmailsService) SendBuilder(ctx context.Context, b *EmailBuilder, opts ...RequestOption) (CreateEmailResponse, *ErrorResponse, error) {
+	payload, err := b.Build()
+	if err != nil {
+		return CreateEmailResponse{}, nil, err
+	}
+	return e.Send(ctx, payload, opts...)
+}
+
+// BatchBuilder validates every email queued on b and dispatches them in one
+// Batch call.
+func (e *EmailsService) BatchBuilder(ctx context.Context, b *BatchEmailBuilder, opts ...RequestOption) (BatchEmailResponse, *ErrorResponse, error) {
+	payloads, err := b.Build()
+	if err != nil {
+		return BatchEmailResponse{}, nil, err
+	}
+	return e.Batch(ctx, payloads, opts...)
+}
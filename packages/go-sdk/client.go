@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 )
 
 const defaultBaseURL = "https://app.usesend.com/api/v1"
@@ -17,8 +18,15 @@ type Client struct {
 	baseURL    string
 	httpClient *http.Client
 
-	Emails   *EmailsService
-	Contacts *ContactsService
+	Emails       *EmailsService
+	Contacts     *ContactsService
+	ContactBooks *ContactBooksService
+	Webhooks     *WebhooksService
+	Domains      *DomainsService
+	ApiKeys      *ApiKeysService
+	Templates    *TemplatesService
+
+	retryPolicy *RetryPolicy
 }
 
 type ClientOption func(*Client)
@@ -58,65 +66,137 @@ func NewClient(apiKey string, opts ...ClientOption) (*Client, error) {
 
 	c.Emails = &EmailsService{client: c}
 	c.Contacts = &ContactsService{client: c}
+	c.ContactBooks = &ContactBooksService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
+	c.Domains = &DomainsService{client: c}
+	c.ApiKeys = &ApiKeysService{client: c}
+	c.Templates = &TemplatesService{client: c}
 
 	return c, nil
 }
 
-func (c *Client) doRequest(ctx context.Context, method, path string, body any, v any) (*ErrorResponse, error) {
-	var buf io.Reader
+// doRequest buffers body (if any) up front so it can be replayed across
+// retry attempts, then issues the request, retrying idempotent calls on
+// network errors, 429s and 5xxs when the client has a RetryPolicy.
+func (c *Client) doRequest(ctx context.Context, method, path string, body any, v any, opts ...RequestOption) (*ErrorResponse, error) {
+	var bodyBytes []byte
 	if body != nil {
-		b := &bytes.Buffer{}
-		if err := json.NewEncoder(b).Encode(body); err != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
 			return nil, err
 		}
-		buf = b
+		bodyBytes = b
+	}
+
+	ro := &requestOptions{}
+	for _, opt := range opts {
+		opt(ro)
+	}
+
+	retryable := c.retryPolicy != nil && (ro.idempotencyKey != "" || isIdempotentMethod(method))
+	attempts := 1
+	if retryable {
+		attempts = c.retryPolicy.MaxRetries + 1
+	}
+
+	var retryAfter time.Duration
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(c.retryPolicy.delay(attempt-1, retryAfter)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		errResp, retry, nextRetryAfter, err := c.doOnce(ctx, method, path, bodyBytes, v, ro)
+		if retry && attempt < attempts-1 {
+			retryAfter = nextRetryAfter
+			continue
+		}
+		return errResp, err
+	}
+	return nil, errors.New("usesend: exhausted retries")
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, bodyBytes []byte, v any, ro *requestOptions) (errResp *ErrorResponse, retry bool, retryAfter time.Duration, err error) {
+	var reqBody io.Reader
+	if bodyBytes != nil {
+		reqBody = bytes.NewReader(bodyBytes)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, buf)
+	reqCtx := ctx
+	cancel := func() {}
+	if ro.timeout > 0 {
+		reqCtx, cancel = context.WithTimeout(ctx, ro.timeout)
+	}
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, method, c.baseURL+path, reqBody)
 	if err != nil {
-		return nil, err
+		return nil, false, 0, err
 	}
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	req.Header.Set("Content-Type", "application/json")
+	for key, values := range ro.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if ro.idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", ro.idempotencyKey)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, c.retryPolicy != nil, 0, err
 	}
 	defer resp.Body.Close()
 
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, 0, err
+	}
+
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if v != nil {
-			if err := json.NewDecoder(resp.Body).Decode(v); err != nil && err != io.EOF {
-				return nil, err
+		if v != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, v); err != nil {
+				return nil, false, 0, err
 			}
 		}
-		return nil, nil
+		return nil, false, 0, nil
 	}
 
-	errResp := &ErrorResponse{Message: resp.Status, Code: "INTERNAL_SERVER_ERROR"}
-	if err := json.NewDecoder(resp.Body).Decode(errResp); err != nil {
-		// use default errResp
-	}
-	return errResp, nil
+	// Code is left unset here: it's only meaningful when the API body
+	// actually carries one, and sentinelFor falls back to StatusCode when
+	// it's empty. Defaulting it to something like "INTERNAL_SERVER_ERROR"
+	// would make every code-less error response (common on 404s, gateway
+	// 429s, etc.) misclassify as ErrServer.
+	ep := &ErrorResponse{Message: resp.Status}
+	_ = json.Unmarshal(respBody, ep)
+	ep.StatusCode = resp.StatusCode
+	ep.RequestID = resp.Header.Get("X-Request-Id")
+	ep.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+
+	return ep, isRetryableStatus(resp.StatusCode), ep.RetryAfter, nil
 }
 
-func (c *Client) get(ctx context.Context, path string, out any) (*ErrorResponse, error) {
-	return c.doRequest(ctx, http.MethodGet, path, nil, out)
+func (c *Client) get(ctx context.Context, path string, out any, opts ...RequestOption) (*ErrorResponse, error) {
+	return c.doRequest(ctx, http.MethodGet, path, nil, out, opts...)
 }
 
-func (c *Client) post(ctx context.Context, path string, body any, out any) (*ErrorResponse, error) {
-	return c.doRequest(ctx, http.MethodPost, path, body, out)
+func (c *Client) post(ctx context.Context, path string, body any, out any, opts ...RequestOption) (*ErrorResponse, error) {
+	return c.doRequest(ctx, http.MethodPost, path, body, out, opts...)
 }
 
-func (c *Client) put(ctx context.Context, path string, body any, out any) (*ErrorResponse, error) {
-	return c.doRequest(ctx, http.MethodPut, path, body, out)
+func (c *Client) put(ctx context.Context, path string, body any, out any, opts ...RequestOption) (*ErrorResponse, error) {
+	return c.doRequest(ctx, http.MethodPut, path, body, out, opts...)
 }
 
-func (c *Client) patch(ctx context.Context, path string, body any, out any) (*ErrorResponse, error) {
-	return c.doRequest(ctx, http.MethodPatch, path, body, out)
+func (c *Client) patch(ctx context.Context, path string, body any, out any, opts ...RequestOption) (*ErrorResponse, error) {
+	return c.doRequest(ctx, http.MethodPatch, path, body, out, opts...)
 }
 
-func (c *Client) delete(ctx context.Context, path string, body any, out any) (*ErrorResponse, error) {
-	return c.doRequest(ctx, http.MethodDelete, path, body, out)
+func (c *Client) delete(ctx context.Context, path string, body any, out any, opts ...RequestOption) (*ErrorResponse, error) {
+	return c.doRequest(ctx, http.MethodDelete, path, body, out, opts...)
 }
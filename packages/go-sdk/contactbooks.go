@@ -0,0 +1,42 @@
+package usesend
+
+import "context"
+
+type ContactBook struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type CreateContactBookPayload struct {
+	Name string `json:"name"`
+}
+
+type DeleteContactBookResponse struct {
+	Success bool `json:"success"`
+}
+
+// ContactBooksService manages contact books, the lists contacts are
+// subscribed to.
+type ContactBooksService struct {
+	client *Client
+}
+
+func (b *ContactBooksService) Create(ctx context.Context, payload CreateContactBookPayload, opts ...RequestOption) (ContactBook, *ErrorResponse, error) {
+	var resp ContactBook
+	errResp, err := b.client.post(ctx, "/contactBooks", payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (b *ContactBooksService) List(ctx context.Context, opts ...RequestOption) ([]ContactBook, *ErrorResponse, error) {
+	var resp []ContactBook
+	errResp, err := b.client.get(ctx, "/contactBooks", &resp, opts...)
+	return resp, errResp, err
+}
+
+func (b *ContactBooksService) Delete(ctx context.Context, id string, opts ...RequestOption) (DeleteContactBookResponse, *ErrorResponse, error) {
+	var resp DeleteContactBookResponse
+	errResp, err := b.client.delete(ctx, "/contactBooks/"+id, nil, &resp, opts...)
+	return resp, errResp, err
+}
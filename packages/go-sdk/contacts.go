@@ -1,6 +1,10 @@
 package usesend
 
-import "context"
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
 
 type Contact struct {
 	ID            string            `json:"id"`
@@ -37,36 +41,125 @@ type DeleteContactResponse struct {
 	Success bool `json:"success"`
 }
 
+// ListOptions paginates a contact book's contacts via an opaque cursor.
+type ListOptions struct {
+	Cursor string
+	Limit  int
+}
+
+type ContactList struct {
+	Contacts   []Contact `json:"contacts"`
+	NextCursor string    `json:"nextCursor,omitempty"`
+}
+
 type ContactsService struct {
 	client *Client
 }
 
-func (c *ContactsService) Create(ctx context.Context, contactBookID string, payload CreateContactPayload) (CreateContactResponse, *ErrorResponse, error) {
+// Deprecated: use ListE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+//
+// List enumerates the contacts in a contact book, paginated via
+// ListOptions.Cursor. Pass the returned ContactList.NextCursor back in to
+// fetch the next page; an empty NextCursor means there are no more pages.
+func (c *ContactsService) List(ctx context.Context, contactBookID string, listOpts ListOptions, opts ...RequestOption) (ContactList, *ErrorResponse, error) {
+	path := "/contactBooks/" + contactBookID + "/contacts"
+
+	q := url.Values{}
+	if listOpts.Cursor != "" {
+		q.Set("cursor", listOpts.Cursor)
+	}
+	if listOpts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(listOpts.Limit))
+	}
+	if encoded := q.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+
+	var resp ContactList
+	errResp, err := c.client.get(ctx, path, &resp, opts...)
+	return resp, errResp, err
+}
+
+// ListE is List, with the error response folded into a single error.
+func (c *ContactsService) ListE(ctx context.Context, contactBookID string, listOpts ListOptions, opts ...RequestOption) (ContactList, error) {
+	resp, errResp, err := c.List(ctx, contactBookID, listOpts, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use CreateE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (c *ContactsService) Create(ctx context.Context, contactBookID string, payload CreateContactPayload, opts ...RequestOption) (CreateContactResponse, *ErrorResponse, error) {
 	var resp CreateContactResponse
-	errResp, err := c.client.post(ctx, "/contactBooks/"+contactBookID+"/contacts", payload, &resp)
+	errResp, err := c.client.post(ctx, "/contactBooks/"+contactBookID+"/contacts", payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (c *ContactsService) Get(ctx context.Context, contactBookID, contactID string) (Contact, *ErrorResponse, error) {
+// CreateE is Create, with the error response folded into a single error.
+func (c *ContactsService) CreateE(ctx context.Context, contactBookID string, payload CreateContactPayload, opts ...RequestOption) (CreateContactResponse, error) {
+	resp, errResp, err := c.Create(ctx, contactBookID, payload, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use GetE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (c *ContactsService) Get(ctx context.Context, contactBookID, contactID string, opts ...RequestOption) (Contact, *ErrorResponse, error) {
 	var resp Contact
-	errResp, err := c.client.get(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, &resp)
+	errResp, err := c.client.get(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (c *ContactsService) Update(ctx context.Context, contactBookID, contactID string, payload UpdateContactPayload) (CreateContactResponse, *ErrorResponse, error) {
+// GetE is Get, with the error response folded into a single error.
+func (c *ContactsService) GetE(ctx context.Context, contactBookID, contactID string, opts ...RequestOption) (Contact, error) {
+	resp, errResp, err := c.Get(ctx, contactBookID, contactID, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use UpdateE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (c *ContactsService) Update(ctx context.Context, contactBookID, contactID string, payload UpdateContactPayload, opts ...RequestOption) (CreateContactResponse, *ErrorResponse, error) {
 	var resp CreateContactResponse
-	errResp, err := c.client.patch(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, payload, &resp)
+	errResp, err := c.client.patch(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (c *ContactsService) Upsert(ctx context.Context, contactBookID, contactID string, payload CreateContactPayload) (CreateContactResponse, *ErrorResponse, error) {
+// UpdateE is Update, with the error response folded into a single error.
+func (c *ContactsService) UpdateE(ctx context.Context, contactBookID, contactID string, payload UpdateContactPayload, opts ...RequestOption) (CreateContactResponse, error) {
+	resp, errResp, err := c.Update(ctx, contactBookID, contactID, payload, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Upsert is sent with an auto-generated Idempotency-Key when the caller
+// doesn't supply one via WithIdempotencyKey. PUT is already idempotent at
+// the resource level, but the key additionally protects against the API
+// applying a retried request's properties twice if it merges rather than
+// replaces.
+//
+// Deprecated: use UpsertE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (c *ContactsService) Upsert(ctx context.Context, contactBookID, contactID string, payload CreateContactPayload, opts ...RequestOption) (CreateContactResponse, *ErrorResponse, error) {
 	var resp CreateContactResponse
-	errResp, err := c.client.put(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, payload, &resp)
+	opts = append([]RequestOption{WithIdempotencyKey(newIdempotencyKey())}, opts...)
+	errResp, err := c.client.put(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (c *ContactsService) Delete(ctx context.Context, contactBookID, contactID string) (DeleteContactResponse, *ErrorResponse, error) {
+// UpsertE is Upsert, with the error response folded into a single error.
+func (c *ContactsService) UpsertE(ctx context.Context, contactBookID, contactID string, payload CreateContactPayload, opts ...RequestOption) (CreateContactResponse, error) {
+	resp, errResp, err := c.Upsert(ctx, contactBookID, contactID, payload, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use DeleteE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (c *ContactsService) Delete(ctx context.Context, contactBookID, contactID string, opts ...RequestOption) (DeleteContactResponse, *ErrorResponse, error) {
 	var resp DeleteContactResponse
-	errResp, err := c.client.delete(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, nil, &resp)
+	errResp, err := c.client.delete(ctx, "/contactBooks/"+contactBookID+"/contacts/"+contactID, nil, &resp, opts...)
 	return resp, errResp, err
 }
+
+// DeleteE is Delete, with the error response folded into a single error.
+func (c *ContactsService) DeleteE(ctx context.Context, contactBookID, contactID string, opts ...RequestOption) (DeleteContactResponse, error) {
+	resp, errResp, err := c.Delete(ctx, contactBookID, contactID, opts...)
+	return resp, foldError(err, errResp)
+}
@@ -0,0 +1,76 @@
+package usesend
+
+import "context"
+
+// DNSRecord is a single DNS record a domain must publish to pass
+// verification, as part of its DKIM, SPF or DMARC setup.
+type DNSRecord struct {
+	Record   string `json:"record"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Priority int    `json:"priority,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+type Domain struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Region       string      `json:"region,omitempty"`
+	Status       string      `json:"status"`
+	DKIMRecords  []DNSRecord `json:"dkimRecords,omitempty"`
+	SPFRecords   []DNSRecord `json:"spfRecords,omitempty"`
+	DMARCRecords []DNSRecord `json:"dmarcRecords,omitempty"`
+	CreatedAt    string      `json:"createdAt"`
+	UpdatedAt    string      `json:"updatedAt"`
+}
+
+type CreateDomainPayload struct {
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+}
+
+type VerifyDomainResponse struct {
+	Status string `json:"status"`
+}
+
+type DeleteDomainResponse struct {
+	Success bool `json:"success"`
+}
+
+// DomainsService manages sending domains and their DKIM/SPF/DMARC records.
+type DomainsService struct {
+	client *Client
+}
+
+func (d *DomainsService) Create(ctx context.Context, payload CreateDomainPayload, opts ...RequestOption) (Domain, *ErrorResponse, error) {
+	var resp Domain
+	errResp, err := d.client.post(ctx, "/domains", payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (d *DomainsService) List(ctx context.Context, opts ...RequestOption) ([]Domain, *ErrorResponse, error) {
+	var resp []Domain
+	errResp, err := d.client.get(ctx, "/domains", &resp, opts...)
+	return resp, errResp, err
+}
+
+func (d *DomainsService) Get(ctx context.Context, id string, opts ...RequestOption) (Domain, *ErrorResponse, error) {
+	var resp Domain
+	errResp, err := d.client.get(ctx, "/domains/"+id, &resp, opts...)
+	return resp, errResp, err
+}
+
+// Verify asks useSend to re-check the domain's DNS records and update its
+// status accordingly.
+func (d *DomainsService) Verify(ctx context.Context, id string, opts ...RequestOption) (VerifyDomainResponse, *ErrorResponse, error) {
+	var resp VerifyDomainResponse
+	errResp, err := d.client.post(ctx, "/domains/"+id+"/verify", nil, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (d *DomainsService) Delete(ctx context.Context, id string, opts ...RequestOption) (DeleteDomainResponse, *ErrorResponse, error) {
+	var resp DeleteDomainResponse
+	errResp, err := d.client.delete(ctx, "/domains/"+id, nil, &resp, opts...)
+	return resp, errResp, err
+}
@@ -3,8 +3,9 @@ package usesend
 import "context"
 
 type Attachment struct {
-	Filename string `json:"filename"`
-	Content  string `json:"content"`
+	Filename    string `json:"filename"`
+	Content     string `json:"content"`
+	ContentType string `json:"contentType,omitempty"`
 }
 
 type SendEmailPayload struct {
@@ -62,36 +63,89 @@ type EmailsService struct {
 	client *Client
 }
 
-func (e *EmailsService) Create(ctx context.Context, payload SendEmailPayload) (CreateEmailResponse, *ErrorResponse, error) {
+// Create sends payload with an auto-generated Idempotency-Key when the
+// caller doesn't supply one via WithIdempotencyKey, so a retried call after
+// a transient network failure won't double-send.
+//
+// Deprecated: use CreateE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Create(ctx context.Context, payload SendEmailPayload, opts ...RequestOption) (CreateEmailResponse, *ErrorResponse, error) {
 	var resp CreateEmailResponse
-	errResp, err := e.client.post(ctx, "/emails", payload, &resp)
+	opts = append([]RequestOption{WithIdempotencyKey(newIdempotencyKey())}, opts...)
+	errResp, err := e.client.post(ctx, "/emails", payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (e *EmailsService) Send(ctx context.Context, payload SendEmailPayload) (CreateEmailResponse, *ErrorResponse, error) {
-	return e.Create(ctx, payload)
+// CreateE is Create, with the error response folded into a single error.
+func (e *EmailsService) CreateE(ctx context.Context, payload SendEmailPayload, opts ...RequestOption) (CreateEmailResponse, error) {
+	resp, errResp, err := e.Create(ctx, payload, opts...)
+	return resp, foldError(err, errResp)
 }
 
-func (e *EmailsService) Batch(ctx context.Context, payload []SendEmailPayload) (BatchEmailResponse, *ErrorResponse, error) {
+// Deprecated: use SendE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Send(ctx context.Context, payload SendEmailPayload, opts ...RequestOption) (CreateEmailResponse, *ErrorResponse, error) {
+	return e.Create(ctx, payload, opts...)
+}
+
+// SendE is Send, with the error response folded into a single error.
+func (e *EmailsService) SendE(ctx context.Context, payload SendEmailPayload, opts ...RequestOption) (CreateEmailResponse, error) {
+	return e.CreateE(ctx, payload, opts...)
+}
+
+// Deprecated: use BatchE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Batch(ctx context.Context, payload []SendEmailPayload, opts ...RequestOption) (BatchEmailResponse, *ErrorResponse, error) {
 	var resp BatchEmailResponse
-	errResp, err := e.client.post(ctx, "/emails/batch", payload, &resp)
+	opts = append([]RequestOption{WithIdempotencyKey(newIdempotencyKey())}, opts...)
+	errResp, err := e.client.post(ctx, "/emails/batch", payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (e *EmailsService) Get(ctx context.Context, id string) (Email, *ErrorResponse, error) {
+// BatchE is Batch, with the error response folded into a single error.
+func (e *EmailsService) BatchE(ctx context.Context, payload []SendEmailPayload, opts ...RequestOption) (BatchEmailResponse, error) {
+	resp, errResp, err := e.Batch(ctx, payload, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use GetE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Get(ctx context.Context, id string, opts ...RequestOption) (Email, *ErrorResponse, error) {
 	var resp Email
-	errResp, err := e.client.get(ctx, "/emails/"+id, &resp)
+	errResp, err := e.client.get(ctx, "/emails/"+id, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (e *EmailsService) Update(ctx context.Context, id string, payload UpdateEmailPayload) (CreateEmailResponse, *ErrorResponse, error) {
+// GetE is Get, with the error response folded into a single error.
+func (e *EmailsService) GetE(ctx context.Context, id string, opts ...RequestOption) (Email, error) {
+	resp, errResp, err := e.Get(ctx, id, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use UpdateE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Update(ctx context.Context, id string, payload UpdateEmailPayload, opts ...RequestOption) (CreateEmailResponse, *ErrorResponse, error) {
 	var resp CreateEmailResponse
-	errResp, err := e.client.patch(ctx, "/emails/"+id, payload, &resp)
+	errResp, err := e.client.patch(ctx, "/emails/"+id, payload, &resp, opts...)
 	return resp, errResp, err
 }
 
-func (e *EmailsService) Cancel(ctx context.Context, id string) (CreateEmailResponse, *ErrorResponse, error) {
+// UpdateE is Update, with the error response folded into a single error.
+func (e *EmailsService) UpdateE(ctx context.Context, id string, payload UpdateEmailPayload, opts ...RequestOption) (CreateEmailResponse, error) {
+	resp, errResp, err := e.Update(ctx, id, payload, opts...)
+	return resp, foldError(err, errResp)
+}
+
+// Deprecated: use CancelE, which folds *ErrorResponse into the returned
+// error so it can be inspected with errors.Is/errors.As.
+func (e *EmailsService) Cancel(ctx context.Context, id string, opts ...RequestOption) (CreateEmailResponse, *ErrorResponse, error) {
 	var resp CreateEmailResponse
-	errResp, err := e.client.post(ctx, "/emails/"+id+"/cancel", nil, &resp)
+	errResp, err := e.client.post(ctx, "/emails/"+id+"/cancel", nil, &resp, opts...)
 	return resp, errResp, err
 }
+
+// CancelE is Cancel, with the error response folded into a single error.
+func (e *EmailsService) CancelE(ctx context.Context, id string, opts ...RequestOption) (CreateEmailResponse, error) {
+	resp, errResp, err := e.Cancel(ctx, id, opts...)
+	return resp, foldError(err, errResp)
+}
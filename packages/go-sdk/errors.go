@@ -0,0 +1,135 @@
+package usesend
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors that APIError wraps so callers can write
+// errors.Is(err, usesend.ErrRateLimited) instead of inspecting status
+// codes.
+var (
+	ErrUnauthorized = errors.New("usesend: unauthorized")
+	ErrRateLimited  = errors.New("usesend: rate limited")
+	ErrNotFound     = errors.New("usesend: not found")
+	ErrValidation   = errors.New("usesend: validation failed")
+	ErrConflict     = errors.New("usesend: conflict")
+	ErrServer       = errors.New("usesend: server error")
+)
+
+// ErrorResponse is the shape of an error body returned by the useSend API.
+//
+// Deprecated: service methods still return this alongside their result for
+// compatibility, but new code should use the *E-suffixed methods (e.g.
+// EmailsService.SendE), which fold it into an *APIError usable with
+// errors.Is and errors.As.
+type ErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	StatusCode int           `json:"-"`
+	RequestID  string        `json:"-"`
+	RetryAfter time.Duration `json:"-"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return e.Message
+}
+
+// AsAPIError converts e into the richer, errors.Is/As-friendly APIError
+// returned by the *E-suffixed service methods.
+func (e *ErrorResponse) AsAPIError() *APIError {
+	return &APIError{
+		StatusCode: e.StatusCode,
+		Code:       e.Code,
+		Message:    e.Message,
+		RequestID:  e.RequestID,
+		RetryAfter: e.RetryAfter,
+		sentinel:   sentinelFor(e.StatusCode, e.Code),
+	}
+}
+
+// APIError is returned by the *E-suffixed service methods (e.g.
+// EmailsService.SendE) when the API responds with a non-2xx status.
+// errors.Is(err, usesend.ErrRateLimited) and similar work because APIError
+// unwraps to the sentinel matching Code, or StatusCode when Code isn't one
+// it recognizes.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	// RetryAfter is populated from the Retry-After header on 429s.
+	RetryAfter time.Duration
+
+	sentinel error
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("usesend: %s (code=%s, status=%d, request_id=%s)", e.Message, e.Code, e.StatusCode, e.RequestID)
+	}
+	return fmt.Sprintf("usesend: %s (code=%s, status=%d)", e.Message, e.Code, e.StatusCode)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.sentinel
+}
+
+// sentinelsByCode maps the API's `code` field to a sentinel error for cases
+// where the HTTP status alone is ambiguous, e.g. a 400 carrying
+// code:"RATE_LIMITED" or code:"CONFLICT" instead of the expected status.
+var sentinelsByCode = map[string]error{
+	"UNAUTHORIZED":          ErrUnauthorized,
+	"FORBIDDEN":             ErrUnauthorized,
+	"RATE_LIMITED":          ErrRateLimited,
+	"NOT_FOUND":             ErrNotFound,
+	"CONFLICT":              ErrConflict,
+	"VALIDATION":            ErrValidation,
+	"BAD_REQUEST":           ErrValidation,
+	"INTERNAL_SERVER_ERROR": ErrServer,
+}
+
+// sentinelFor picks the sentinel error for a response, preferring the API's
+// `code` field when it's one we recognize and falling back to the HTTP
+// status otherwise.
+func sentinelFor(status int, code string) error {
+	if sentinel, ok := sentinelsByCode[code]; ok {
+		return sentinel
+	}
+	return sentinelForStatus(status)
+}
+
+func sentinelForStatus(status int) error {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrUnauthorized
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status == http.StatusConflict:
+		return ErrConflict
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case status >= 500:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// foldError folds a service method's triple-return error signals into a
+// single error: a network/context error takes precedence, otherwise a
+// non-nil errResp is converted to an *APIError.
+func foldError(err error, errResp *ErrorResponse) error {
+	if err != nil {
+		return err
+	}
+	if errResp != nil {
+		return errResp.AsAPIError()
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package usesend
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newIdempotencyKey generates a random UUIDv4 for use as an Idempotency-Key
+// header when the caller hasn't supplied one.
+func newIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
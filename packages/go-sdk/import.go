@@ -0,0 +1,606 @@
+package usesend
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ImportFormat selects how Import parses the input stream.
+type ImportFormat int
+
+const (
+	ImportFormatCSV ImportFormat = iota
+	ImportFormatJSONL
+)
+
+// DuplicateHandling controls what Import does with a row that dedupes to
+// an existing contact, keyed by ImportColumnMapping.ID when the row has
+// one, otherwise by email.
+type DuplicateHandling int
+
+const (
+	// DuplicateSkip leaves the existing contact untouched.
+	DuplicateSkip DuplicateHandling = iota
+	// DuplicateOverwrite replaces the existing contact's fields with the
+	// row's.
+	DuplicateOverwrite
+	// DuplicateMerge fetches the existing contact and merges the row's
+	// properties into its existing ones before writing.
+	DuplicateMerge
+)
+
+// rowOutcome is what importRow did with a row, once Err on the returned
+// error is known to be nil.
+type rowOutcome int
+
+const (
+	rowCreated rowOutcome = iota
+	rowUpdated
+	rowSkipped
+)
+
+// ImportColumnMapping maps CSV columns or JSONL object keys onto Contact
+// fields. Any field left empty falls back to its camelCase name (e.g.
+// Email defaults to the "email" column, FirstName to "firstName").
+type ImportColumnMapping struct {
+	ID         string
+	Email      string
+	FirstName  string
+	LastName   string
+	Subscribed string
+	// Properties maps a source column/key name to the destination
+	// property name. CSV columns not listed here but named
+	// "properties.<key>" are mapped to <key> automatically; for JSONL, the
+	// keys of a nested "properties" object are used instead, and flat
+	// "properties.<key>" keys are not mapped automatically.
+	Properties map[string]string
+}
+
+// ImportProgress is reported to ImportOptions.OnProgress after every row.
+type ImportProgress struct {
+	Processed int
+	Created   int
+	Updated   int
+	Skipped   int
+	Failed    int
+}
+
+// ImportOptions configures ContactsService.Import.
+type ImportOptions struct {
+	Format            ImportFormat
+	ColumnMapping     ImportColumnMapping
+	DuplicateHandling DuplicateHandling
+	// Concurrency is the number of upserts in flight at once. Defaults to 4.
+	Concurrency int
+	// RatePerSecond caps upserts per second across all workers. Zero means
+	// unlimited.
+	RatePerSecond int
+	// DryRun validates and parses every row without creating or updating
+	// any contact. Existing contacts are still looked up through the API so
+	// the report can tell creates from updates.
+	DryRun bool
+	// CheckpointPath, if set, records the last contiguously-completed row
+	// so a re-run of Import against the same reader resumes after it
+	// instead of re-importing from the start.
+	CheckpointPath string
+	OnProgress     func(ImportProgress)
+}
+
+// ImportRowError is a single row's failure, keyed by its 0-based position
+// in the input stream.
+type ImportRowError struct {
+	Row   int
+	Email string
+	Err   error
+}
+
+// ImportReport summarizes an Import run.
+type ImportReport struct {
+	Created int
+	Updated int
+	Skipped int
+	Failed  int
+	Errors  []ImportRowError
+}
+
+type importRow struct {
+	id         string
+	email      string
+	firstName  string
+	lastName   string
+	subscribed bool
+	properties map[string]string
+}
+
+// Import streams contactBookID's bulk upsert data from r (CSV or JSONL,
+// per opts.Format) through a worker pool, optionally rate-limited, and
+// returns a report of what happened. Rows dedupe against an existing
+// contact keyed by ImportColumnMapping.ID when present, otherwise by
+// email, and are written per opts.DuplicateHandling; rows that don't
+// dedupe to anything are created.
+func (c *ContactsService) Import(ctx context.Context, contactBookID string, r io.Reader, opts ImportOptions) (ImportReport, error) {
+	reader, err := newImportRowReader(r, opts)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	resumeFrom := 0
+	if opts.CheckpointPath != "" {
+		resumeFrom, err = readImportCheckpoint(opts.CheckpointPath)
+		if err != nil {
+			return ImportReport{}, err
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var limiter *importRateLimiter
+	if opts.RatePerSecond > 0 {
+		limiter = newImportRateLimiter(opts.RatePerSecond)
+		defer limiter.Close()
+	}
+
+	// A dry run must never advance the checkpoint: doing so would make the
+	// following real run skip rows it hasn't actually imported yet.
+	checkpointPath := opts.CheckpointPath
+	if opts.DryRun {
+		checkpointPath = ""
+	}
+	checkpoint := newImportCheckpointTracker(resumeFrom, checkpointPath)
+
+	type job struct {
+		index int
+		row   importRow
+	}
+
+	var (
+		mu       sync.Mutex
+		report   ImportReport
+		progress ImportProgress
+		wg       sync.WaitGroup
+	)
+
+	record := func(index int, email string, outcome rowOutcome, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			report.Failed++
+			report.Errors = append(report.Errors, ImportRowError{Row: index, Email: email, Err: err})
+		} else {
+			switch outcome {
+			case rowCreated:
+				report.Created++
+			case rowUpdated:
+				report.Updated++
+			case rowSkipped:
+				report.Skipped++
+			}
+		}
+
+		progress.Processed++
+		progress.Created = report.Created
+		progress.Updated = report.Updated
+		progress.Skipped = report.Skipped
+		progress.Failed = report.Failed
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+	}
+
+	jobs := make(chan job)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if limiter != nil {
+					if err := limiter.Wait(ctx); err != nil {
+						record(j.index, j.row.email, 0, err)
+						continue
+					}
+				}
+				outcome, err := c.importRow(ctx, contactBookID, j.row, opts)
+				record(j.index, j.row.email, outcome, err)
+				// Only a row that actually succeeded may advance the
+				// checkpoint; a failed row must be retried on the next run,
+				// not silently skipped as "already imported".
+				if err == nil {
+					checkpoint.MarkDone(j.index)
+				}
+			}
+		}()
+	}
+
+	index := -1
+streamLoop:
+	for {
+		row, ok, err := reader.Next()
+		if err != nil {
+			close(jobs)
+			wg.Wait()
+			return report, err
+		}
+		if !ok {
+			break
+		}
+		index++
+
+		if index < resumeFrom {
+			checkpoint.MarkDone(index)
+			continue
+		}
+
+		select {
+		case jobs <- job{index: index, row: row}:
+		case <-ctx.Done():
+			break streamLoop
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return report, ctx.Err()
+}
+
+// importRow dedupes row against an existing contact keyed by row.id when
+// present, otherwise by row.email, and upserts it accordingly. The contacts
+// API keys Get/Upsert by contact ID, not email, so an email-only row is
+// resolved to an ID via lookupContactByEmail before it can be upserted; a
+// row with no match yet is created instead (Upsert needs an ID to PUT to,
+// which an unmatched row doesn't have).
+func (c *ContactsService) importRow(ctx context.Context, contactBookID string, row importRow, opts ImportOptions) (rowOutcome, error) {
+	if row.email == "" {
+		return 0, fmt.Errorf("usesend: row is missing an email")
+	}
+
+	payload := CreateContactPayload{
+		Email:      row.email,
+		FirstName:  row.firstName,
+		LastName:   row.lastName,
+		Properties: row.properties,
+		Subscribed: row.subscribed,
+	}
+
+	existing, exists, err := c.lookupExistingContact(ctx, contactBookID, row.id, row.email)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		if opts.DryRun {
+			return rowCreated, nil
+		}
+		_, errResp, reqErr := c.Create(ctx, contactBookID, payload)
+		return rowCreated, foldError(reqErr, errResp)
+	}
+
+	if opts.DuplicateHandling == DuplicateSkip {
+		return rowSkipped, nil
+	}
+
+	if opts.DuplicateHandling == DuplicateMerge {
+		merged := make(map[string]string, len(existing.Properties)+len(row.properties))
+		for k, v := range existing.Properties {
+			merged[k] = v
+		}
+		for k, v := range row.properties {
+			merged[k] = v
+		}
+		payload.Properties = merged
+	}
+
+	if opts.DryRun {
+		return rowUpdated, nil
+	}
+	_, errResp, reqErr := c.Upsert(ctx, contactBookID, existing.ID, payload)
+	return rowUpdated, foldError(reqErr, errResp)
+}
+
+// lookupExistingContact reports whether a contact already exists for the
+// row, tolerating a not-found response as "doesn't exist" rather than an
+// error. It looks up by id when the row has one; otherwise it falls back to
+// scanning the contact book for a matching email.
+func (c *ContactsService) lookupExistingContact(ctx context.Context, contactBookID, id, email string) (Contact, bool, error) {
+	if id == "" {
+		return c.lookupContactByEmail(ctx, contactBookID, email)
+	}
+
+	contact, errResp, err := c.Get(ctx, contactBookID, id)
+	if err != nil {
+		return Contact{}, false, err
+	}
+	if errResp != nil {
+		if errResp.StatusCode == http.StatusNotFound {
+			return Contact{}, false, nil
+		}
+		return Contact{}, false, errResp
+	}
+	return contact, true, nil
+}
+
+// lookupContactByEmail pages through contactBookID's contacts looking for
+// one matching email. The contacts API has no get-by-email endpoint, so
+// this is the only way to resolve an email-only row to the contact ID that
+// Get/Upsert require.
+func (c *ContactsService) lookupContactByEmail(ctx context.Context, contactBookID, email string) (Contact, bool, error) {
+	var cursor string
+	for {
+		list, errResp, err := c.List(ctx, contactBookID, ListOptions{Cursor: cursor, Limit: 100})
+		if err != nil {
+			return Contact{}, false, err
+		}
+		if errResp != nil {
+			return Contact{}, false, errResp
+		}
+
+		for _, contact := range list.Contacts {
+			if contact.Email == email {
+				return contact, true, nil
+			}
+		}
+
+		if list.NextCursor == "" {
+			return Contact{}, false, nil
+		}
+		cursor = list.NextCursor
+	}
+}
+
+// importRowReader streams one normalized importRow at a time from the
+// underlying CSV or JSONL source.
+type importRowReader interface {
+	// Next returns the next row. ok is false once the stream is exhausted.
+	Next() (importRow, bool, error)
+}
+
+func newImportRowReader(r io.Reader, opts ImportOptions) (importRowReader, error) {
+	switch opts.Format {
+	case ImportFormatJSONL:
+		return &jsonlRowReader{scanner: bufio.NewScanner(r), mapping: opts.ColumnMapping}, nil
+	default:
+		return newCSVRowReader(r, opts.ColumnMapping)
+	}
+}
+
+type csvRowReader struct {
+	reader  *csv.Reader
+	mapping ImportColumnMapping
+	columns map[string]int
+}
+
+func newCSVRowReader(r io.Reader, mapping ImportColumnMapping) (*csvRowReader, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("usesend: reading CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+
+	return &csvRowReader{reader: cr, mapping: mapping, columns: columns}, nil
+}
+
+func (cr *csvRowReader) Next() (importRow, bool, error) {
+	record, err := cr.reader.Read()
+	if err == io.EOF {
+		return importRow{}, false, nil
+	}
+	if err != nil {
+		return importRow{}, false, err
+	}
+
+	get := func(name string) string {
+		i, ok := cr.columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	row := importRow{
+		id:         get(mappedColumn(cr.mapping.ID, "id")),
+		email:      get(mappedColumn(cr.mapping.Email, "email")),
+		firstName:  get(mappedColumn(cr.mapping.FirstName, "firstName")),
+		lastName:   get(mappedColumn(cr.mapping.LastName, "lastName")),
+		subscribed: parseBool(get(mappedColumn(cr.mapping.Subscribed, "subscribed"))),
+		properties: map[string]string{},
+	}
+
+	for name, dest := range cr.mapping.Properties {
+		if v := get(name); v != "" {
+			row.properties[dest] = v
+		}
+	}
+	for name, i := range cr.columns {
+		if rest, ok := strings.CutPrefix(name, "properties."); ok {
+			if _, explicit := cr.mapping.Properties[name]; !explicit && i < len(record) && record[i] != "" {
+				row.properties[rest] = record[i]
+			}
+		}
+	}
+
+	return row, true, nil
+}
+
+type jsonlRowReader struct {
+	scanner *bufio.Scanner
+	mapping ImportColumnMapping
+}
+
+func (jr *jsonlRowReader) Next() (importRow, bool, error) {
+	for jr.scanner.Scan() {
+		line := strings.TrimSpace(jr.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			return importRow{}, false, fmt.Errorf("usesend: decoding JSONL row: %w", err)
+		}
+
+		get := func(name string) string {
+			v, ok := fields[name]
+			if !ok {
+				return ""
+			}
+			return fmt.Sprint(v)
+		}
+
+		row := importRow{
+			id:         get(mappedColumn(jr.mapping.ID, "id")),
+			email:      get(mappedColumn(jr.mapping.Email, "email")),
+			firstName:  get(mappedColumn(jr.mapping.FirstName, "firstName")),
+			lastName:   get(mappedColumn(jr.mapping.LastName, "lastName")),
+			subscribed: parseBool(get(mappedColumn(jr.mapping.Subscribed, "subscribed"))),
+			properties: map[string]string{},
+		}
+
+		if nested, ok := fields["properties"].(map[string]any); ok {
+			for k, v := range nested {
+				row.properties[k] = fmt.Sprint(v)
+			}
+		}
+		for name, dest := range jr.mapping.Properties {
+			if v := get(name); v != "" {
+				row.properties[dest] = v
+			}
+		}
+
+		return row, true, nil
+	}
+	return importRow{}, false, jr.scanner.Err()
+}
+
+func mappedColumn(configured, fallback string) string {
+	if configured != "" {
+		return configured
+	}
+	return fallback
+}
+
+func parseBool(s string) bool {
+	b, _ := strconv.ParseBool(s)
+	return b
+}
+
+// importCheckpointTracker records the index of the first row not yet
+// completed, i.e. one past the highest row index completed contiguously
+// from the start, so a re-run can resume at it even though rows may finish
+// out of order under concurrency.
+type importCheckpointTracker struct {
+	mu        sync.Mutex
+	completed map[int]bool
+	next      int
+	path      string
+}
+
+func newImportCheckpointTracker(resumeFrom int, path string) *importCheckpointTracker {
+	return &importCheckpointTracker{
+		completed: make(map[int]bool),
+		next:      resumeFrom,
+		path:      path,
+	}
+}
+
+func (t *importCheckpointTracker) MarkDone(index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.completed[index] = true
+	for t.completed[t.next] {
+		delete(t.completed, t.next)
+		t.next++
+	}
+
+	if t.path != "" {
+		_ = writeImportCheckpoint(t.path, t.next)
+	}
+}
+
+func readImportCheckpoint(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("usesend: invalid checkpoint file %s: %w", path, err)
+	}
+	return n, nil
+}
+
+func writeImportCheckpoint(path string, row int) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(row)), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// importRateLimiter caps throughput to a fixed number of operations per
+// second across every worker.
+type importRateLimiter struct {
+	tokens chan struct{}
+	stop   chan struct{}
+}
+
+func newImportRateLimiter(perSecond int) *importRateLimiter {
+	rl := &importRateLimiter{
+		tokens: make(chan struct{}, perSecond),
+		stop:   make(chan struct{}),
+	}
+
+	interval := time.Second / time.Duration(perSecond)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case rl.tokens <- struct{}{}:
+				default:
+				}
+			case <-rl.stop:
+				return
+			}
+		}
+	}()
+
+	return rl
+}
+
+func (rl *importRateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-rl.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (rl *importRateLimiter) Close() {
+	close(rl.stop)
+}
@@ -0,0 +1,45 @@
+package usesend
+
+import (
+	"net/http"
+	"time"
+)
+
+// requestOptions holds the per-call settings assembled from a RequestOption
+// slice before a request is sent.
+type requestOptions struct {
+	headers        http.Header
+	timeout        time.Duration
+	idempotencyKey string
+}
+
+// RequestOption customizes a single service method call, analogous to the
+// client-wide ClientOption.
+type RequestOption func(*requestOptions)
+
+// WithIdempotencyKey sets the Idempotency-Key header so the API can dedupe
+// retried or repeated calls.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// WithHeader sets an additional header on the outgoing request.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Set(key, value)
+	}
+}
+
+// WithTimeout bounds each HTTP attempt of a call, on top of any deadline on
+// the context passed by the caller. When the client retries, every attempt
+// gets a fresh timeout, so the call as a whole can take longer than d.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
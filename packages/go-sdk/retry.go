@@ -0,0 +1,82 @@
+package usesend
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries in Client.doRequest. Retries only
+// ever apply to requests that are safe to repeat: GET/PUT/DELETE, and any
+// other request (e.g. a POST) that carries an Idempotency-Key.
+type RetryPolicy struct {
+	// MaxRetries is the number of attempts after the initial one.
+	MaxRetries int
+	// BaseDelay is the starting backoff delay, doubled on each attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay, before jitter.
+	MaxDelay time.Duration
+}
+
+// WithRetry enables automatic retries with exponential backoff and jitter
+// for idempotent requests that fail with a 429 or 5xx response.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &policy
+	}
+}
+
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+	max := p.MaxDelay
+	if max <= 0 {
+		max = 10 * time.Second
+	}
+
+	d := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if d > max {
+		d = max
+	}
+
+	// Full jitter: a uniform random delay between 0 and d.
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter reads the Retry-After header, which the API may send as
+// either a number of seconds or an HTTP-date.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,94 @@
+package usesend
+
+import (
+	"context"
+	"strings"
+)
+
+type Template struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Subject   string `json:"subject"`
+	HTML      string `json:"html,omitempty"`
+	Text      string `json:"text,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type CreateTemplatePayload struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+type UpdateTemplatePayload struct {
+	Name    string `json:"name,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+type DeleteTemplateResponse struct {
+	Success bool `json:"success"`
+}
+
+// RenderedTemplate is a Template with its Variables substituted in.
+type RenderedTemplate struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Render substitutes variables into the template's subject/html/text,
+// client-side, using the "{{name}}" placeholder syntax, for previewing a
+// template without a network round-trip.
+func (t Template) Render(variables map[string]string) RenderedTemplate {
+	return RenderedTemplate{
+		Subject: substituteVariables(t.Subject, variables),
+		HTML:    substituteVariables(t.HTML, variables),
+		Text:    substituteVariables(t.Text, variables),
+	}
+}
+
+func substituteVariables(s string, variables map[string]string) string {
+	for name, value := range variables {
+		s = strings.ReplaceAll(s, "{{"+name+"}}", value)
+	}
+	return s
+}
+
+// TemplatesService manages reusable email templates.
+type TemplatesService struct {
+	client *Client
+}
+
+func (t *TemplatesService) Create(ctx context.Context, payload CreateTemplatePayload, opts ...RequestOption) (Template, *ErrorResponse, error) {
+	var resp Template
+	errResp, err := t.client.post(ctx, "/templates", payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (t *TemplatesService) List(ctx context.Context, opts ...RequestOption) ([]Template, *ErrorResponse, error) {
+	var resp []Template
+	errResp, err := t.client.get(ctx, "/templates", &resp, opts...)
+	return resp, errResp, err
+}
+
+func (t *TemplatesService) Get(ctx context.Context, id string, opts ...RequestOption) (Template, *ErrorResponse, error) {
+	var resp Template
+	errResp, err := t.client.get(ctx, "/templates/"+id, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (t *TemplatesService) Update(ctx context.Context, id string, payload UpdateTemplatePayload, opts ...RequestOption) (Template, *ErrorResponse, error) {
+	var resp Template
+	errResp, err := t.client.patch(ctx, "/templates/"+id, payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (t *TemplatesService) Delete(ctx context.Context, id string, opts ...RequestOption) (DeleteTemplateResponse, *ErrorResponse, error) {
+	var resp DeleteTemplateResponse
+	errResp, err := t.client.delete(ctx, "/templates/"+id, nil, &resp, opts...)
+	return resp, errResp, err
+}
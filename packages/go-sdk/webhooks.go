@@ -0,0 +1,78 @@
+package usesend
+
+import "context"
+
+// Webhook event type values, mirroring the EmailEvent.Status values emitted
+// on the Email resource itself.
+const (
+	WebhookEventDelivered    = "DELIVERED"
+	WebhookEventBounced      = "BOUNCED"
+	WebhookEventComplained   = "COMPLAINED"
+	WebhookEventOpened       = "OPENED"
+	WebhookEventClicked      = "CLICKED"
+	WebhookEventUnsubscribed = "UNSUBSCRIBED"
+)
+
+// Webhook is an endpoint configured to receive delivery events for a team.
+type Webhook struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	EnabledEvents []string `json:"enabledEvents"`
+	Secret        string   `json:"secret,omitempty"`
+	CreatedAt     string   `json:"createdAt"`
+	UpdatedAt     string   `json:"updatedAt"`
+}
+
+type CreateWebhookPayload struct {
+	URL           string   `json:"url"`
+	EnabledEvents []string `json:"enabledEvents"`
+	// Secret signs the webhook payloads this endpoint receives, for
+	// verification with webhooks.Handler. Leave empty to have useSend
+	// generate one; it's only ever returned in the Create response.
+	Secret string `json:"secret,omitempty"`
+}
+
+type UpdateWebhookPayload struct {
+	URL           string   `json:"url,omitempty"`
+	EnabledEvents []string `json:"enabledEvents,omitempty"`
+	Secret        string   `json:"secret,omitempty"`
+}
+
+type DeleteWebhookResponse struct {
+	Success bool `json:"success"`
+}
+
+// WebhooksService manages webhook endpoints registered against a team.
+type WebhooksService struct {
+	client *Client
+}
+
+func (w *WebhooksService) Create(ctx context.Context, payload CreateWebhookPayload, opts ...RequestOption) (Webhook, *ErrorResponse, error) {
+	var resp Webhook
+	errResp, err := w.client.post(ctx, "/webhooks", payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (w *WebhooksService) List(ctx context.Context, opts ...RequestOption) ([]Webhook, *ErrorResponse, error) {
+	var resp []Webhook
+	errResp, err := w.client.get(ctx, "/webhooks", &resp, opts...)
+	return resp, errResp, err
+}
+
+func (w *WebhooksService) Get(ctx context.Context, id string, opts ...RequestOption) (Webhook, *ErrorResponse, error) {
+	var resp Webhook
+	errResp, err := w.client.get(ctx, "/webhooks/"+id, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (w *WebhooksService) Update(ctx context.Context, id string, payload UpdateWebhookPayload, opts ...RequestOption) (Webhook, *ErrorResponse, error) {
+	var resp Webhook
+	errResp, err := w.client.patch(ctx, "/webhooks/"+id, payload, &resp, opts...)
+	return resp, errResp, err
+}
+
+func (w *WebhooksService) Delete(ctx context.Context, id string, opts ...RequestOption) (DeleteWebhookResponse, *ErrorResponse, error) {
+	var resp DeleteWebhookResponse
+	errResp, err := w.client.delete(ctx, "/webhooks/"+id, nil, &resp, opts...)
+	return resp, errResp, err
+}
@@ -0,0 +1,55 @@
+// Package webhooks verifies and decodes useSend webhook deliveries.
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event type values, mirroring usesend.WebhookEventDelivered and friends.
+const (
+	EventDelivered    = "DELIVERED"
+	EventBounced      = "BOUNCED"
+	EventComplained   = "COMPLAINED"
+	EventOpened       = "OPENED"
+	EventClicked      = "CLICKED"
+	EventUnsubscribed = "UNSUBSCRIBED"
+)
+
+// Payload is the raw envelope useSend posts to a webhook endpoint. Data is
+// left undecoded so it can be unmarshaled into the typed struct matching
+// Type.
+type Payload struct {
+	Type      string          `json:"type"`
+	EmailID   string          `json:"emailId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// EmailEventData is the data shape shared by delivered, opened and
+// unsubscribed events.
+type EmailEventData struct {
+	EmailID string   `json:"emailId"`
+	To      []string `json:"to"`
+	From    string   `json:"from"`
+	Subject string   `json:"subject"`
+}
+
+// BounceEventData is the data shape of a BOUNCED event.
+type BounceEventData struct {
+	EmailEventData
+	BounceType    string `json:"bounceType,omitempty"`
+	BounceSubType string `json:"bounceSubType,omitempty"`
+}
+
+// ComplaintEventData is the data shape of a COMPLAINED event.
+type ComplaintEventData struct {
+	EmailEventData
+	ComplaintFeedbackType string `json:"complaintFeedbackType,omitempty"`
+}
+
+// ClickEventData is the data shape of a CLICKED event.
+type ClickEventData struct {
+	EmailEventData
+	Link string `json:"link"`
+}
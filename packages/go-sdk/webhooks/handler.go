@@ -0,0 +1,181 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	signatureHeader = "UseSend-Signature"
+	timestampHeader = "UseSend-Timestamp"
+
+	defaultTolerance = 5 * time.Minute
+)
+
+var (
+	ErrMissingHeaders          = errors.New("webhooks: missing signature headers")
+	ErrInvalidTimestamp        = errors.New("webhooks: invalid timestamp header")
+	ErrTimestampOutOfTolerance = errors.New("webhooks: timestamp outside of tolerance")
+	ErrSignatureMismatch       = errors.New("webhooks: signature mismatch")
+)
+
+// Handler verifies and decodes inbound useSend webhook requests, dispatching
+// to the callbacks registered via OnDelivered, OnBounced, etc. It implements
+// http.Handler so it can be mounted directly on a mux.
+type Handler struct {
+	secret    string
+	tolerance time.Duration
+
+	onEvent        func(context.Context, Payload)
+	onDelivered    func(context.Context, EmailEventData)
+	onBounced      func(context.Context, BounceEventData)
+	onComplained   func(context.Context, ComplaintEventData)
+	onOpened       func(context.Context, EmailEventData)
+	onClicked      func(context.Context, ClickEventData)
+	onUnsubscribed func(context.Context, EmailEventData)
+}
+
+type HandlerOption func(*Handler)
+
+// WithTolerance overrides the default 5 minute clock-skew window allowed
+// between the request's timestamp header and the current time.
+func WithTolerance(d time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.tolerance = d
+	}
+}
+
+// NewHandler builds a webhook Handler that verifies deliveries against
+// signingSecret.
+func NewHandler(signingSecret string, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		secret:    signingSecret,
+		tolerance: defaultTolerance,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *Handler) OnEvent(fn func(context.Context, Payload)) { h.onEvent = fn }
+
+func (h *Handler) OnDelivered(fn func(context.Context, EmailEventData)) { h.onDelivered = fn }
+
+func (h *Handler) OnBounced(fn func(context.Context, BounceEventData)) { h.onBounced = fn }
+
+func (h *Handler) OnComplained(fn func(context.Context, ComplaintEventData)) { h.onComplained = fn }
+
+func (h *Handler) OnOpened(fn func(context.Context, EmailEventData)) { h.onOpened = fn }
+
+func (h *Handler) OnClicked(fn func(context.Context, ClickEventData)) { h.onClicked = fn }
+
+func (h *Handler) OnUnsubscribed(fn func(context.Context, EmailEventData)) { h.onUnsubscribed = fn }
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	if err := h.verify(r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	h.dispatch(r.Context(), payload)
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify checks the request's signature header against an HMAC-SHA256 of
+// "timestamp.body" keyed by the signing secret, using a constant-time
+// comparison, and rejects requests whose timestamp falls outside the
+// configured tolerance window to guard against replay.
+func (h *Handler) verify(header http.Header, body []byte) error {
+	sig := header.Get(signatureHeader)
+	ts := header.Get(timestampHeader)
+	if sig == "" || ts == "" {
+		return ErrMissingHeaders
+	}
+
+	seconds, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if diff := time.Since(time.Unix(seconds, 0)); diff > h.tolerance || diff < -h.tolerance {
+		return ErrTimestampOutOfTolerance
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+func (h *Handler) dispatch(ctx context.Context, payload Payload) {
+	if h.onEvent != nil {
+		h.onEvent(ctx, payload)
+	}
+
+	switch payload.Type {
+	case EventDelivered:
+		if h.onDelivered != nil {
+			var data EmailEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onDelivered(ctx, data)
+		}
+	case EventBounced:
+		if h.onBounced != nil {
+			var data BounceEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onBounced(ctx, data)
+		}
+	case EventComplained:
+		if h.onComplained != nil {
+			var data ComplaintEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onComplained(ctx, data)
+		}
+	case EventOpened:
+		if h.onOpened != nil {
+			var data EmailEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onOpened(ctx, data)
+		}
+	case EventClicked:
+		if h.onClicked != nil {
+			var data ClickEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onClicked(ctx, data)
+		}
+	case EventUnsubscribed:
+		if h.onUnsubscribed != nil {
+			var data EmailEventData
+			json.Unmarshal(payload.Data, &data)
+			h.onUnsubscribed(ctx, data)
+		}
+	}
+}